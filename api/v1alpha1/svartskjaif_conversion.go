@@ -35,7 +35,9 @@ func (src *SvartSkjaif) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Kaffe = src.Spec.SvartSkjaifContainer.Kaffe
 
 	// Status
-	// Add any status field conversions here
+	dst.Status.ObservedGeneration = src.Status.ObservedGeneration
+	dst.Status.Conditions = src.Status.Conditions
+	dst.Status.ReconcileRequestStatus = src.Status.ReconcileRequestStatus
 
 	return nil
 }
@@ -53,7 +55,9 @@ func (dst *SvartSkjaif) ConvertFrom(srcRaw conversion.Hub) error {
 	dst.Spec.SvartSkjaifContainer.Kaffe = src.Spec.Kaffe
 
 	// Status
-	// Add any status field conversions here
+	dst.Status.ObservedGeneration = src.Status.ObservedGeneration
+	dst.Status.Conditions = src.Status.Conditions
+	dst.Status.ReconcileRequestStatus = src.Status.ReconcileRequestStatus
 
 	return nil
 }
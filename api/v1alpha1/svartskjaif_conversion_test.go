@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/hauks1/skjaiferator/api/v1beta1"
+)
+
+// TestConversionRoundTrip ensures the webhook-applied defaults on the
+// v1beta1 hub survive a v1beta1 -> v1alpha1 -> v1beta1 round trip without
+// drift, for both spec and status.
+func TestConversionRoundTrip(t *testing.T) {
+	original := &v1beta1.SvartSkjaif{
+		ObjectMeta: metav1.ObjectMeta{Name: "drip", Namespace: "default"},
+		Spec: v1beta1.SvartSkjaifSpec{
+			Kaffe: "svart",
+			Kopp:  "mummi",
+			Vann:  "varmt",
+		},
+		Status: v1beta1.SvartSkjaifStatus{
+			ObservedGeneration: 3,
+			Conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Reconciled"},
+			},
+			ReconcileRequestStatus: v1beta1.ReconcileRequestStatus{
+				LastHandledReconcileAt: "2026-07-26T00:00:00Z",
+			},
+		},
+	}
+
+	spoke := &SvartSkjaif{}
+	if err := spoke.ConvertFrom(original); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+
+	roundTripped := &v1beta1.SvartSkjaif{}
+	if err := spoke.ConvertTo(roundTripped); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+
+	if roundTripped.Spec != original.Spec {
+		t.Fatalf("spec drifted across round trip: got %+v, want %+v", roundTripped.Spec, original.Spec)
+	}
+	if roundTripped.Status.ObservedGeneration != original.Status.ObservedGeneration {
+		t.Fatalf("observedGeneration drifted: got %d, want %d",
+			roundTripped.Status.ObservedGeneration, original.Status.ObservedGeneration)
+	}
+	if roundTripped.Status.LastHandledReconcileAt != original.Status.LastHandledReconcileAt {
+		t.Fatalf("lastHandledReconcileAt drifted: got %q, want %q",
+			roundTripped.Status.LastHandledReconcileAt, original.Status.LastHandledReconcileAt)
+	}
+	if len(roundTripped.Status.Conditions) != len(original.Status.Conditions) {
+		t.Fatalf("conditions drifted: got %+v, want %+v", roundTripped.Status.Conditions, original.Status.Conditions)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SvartSkjaifSpec defines the desired state of SvartSkjaif.
+type SvartSkjaifSpec struct {
+	// Kaffe is the coffee blend. Defaults to "svart".
+	// +kubebuilder:validation:Enum=svart
+	Kaffe string `json:"kaffe,omitempty"`
+
+	// Kopp is the cup it is served in. Defaults to "mummi".
+	// +kubebuilder:validation:Enum=mummi
+	Kopp string `json:"kopp,omitempty"`
+
+	// Vann is the water temperature. Defaults to "varmt".
+	// +kubebuilder:validation:Enum=varmt
+	Vann string `json:"vann,omitempty"`
+}
+
+// ReconcileRequestStatus is embedded in SvartSkjaifStatus so the controller
+// can record the last reconcile-request annotation value it handled.
+type ReconcileRequestStatus struct {
+	// LastHandledReconcileAt holds the value of the most recent
+	// skjaif.skjaiferator.no/requestedAt annotation that was handled by the
+	// controller. It is not guaranteed to be a timestamp; it is just a
+	// unique, in-order string that can be compared against the annotation.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+}
+
+// SvartSkjaifStatus defines the observed state of SvartSkjaif.
+type SvartSkjaifStatus struct {
+	// ObservedGeneration is the most recent generation observed by the
+	// controller when it last wrote status.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the
+	// SvartSkjaif's state, e.g. Ready, Progressing, Degraded.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// +optional
+	ReconcileRequestStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// SvartSkjaif is the Schema for the svartskjaifs API.
+type SvartSkjaif struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SvartSkjaifSpec   `json:"spec,omitempty"`
+	Status SvartSkjaifStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SvartSkjaifList contains a list of SvartSkjaif.
+type SvartSkjaifList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SvartSkjaif `json:"items"`
+}
+
+// Hub marks SvartSkjaif as the conversion hub version for the skjaif API group.
+func (*SvartSkjaif) Hub() {}
+
+func init() {
+	SchemeBuilder.Register(&SvartSkjaif{}, &SvartSkjaifList{})
+}
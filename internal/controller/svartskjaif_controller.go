@@ -18,15 +18,45 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	skjaifv1alpha1 "github.com/hauks1/skjaiferator/api/v1alpha1"
 )
 
+// reconcileRequestAnnotation lets users poke the controller into an
+// immediate reconcile without touching the spec, mirroring Flux's
+// reconcile.fluxcd.io/requestedAt convention.
+const reconcileRequestAnnotation = "skjaif.skjaiferator.no/requestedAt"
+
+// svartSkjaifFieldOwner identifies this controller as the field manager for
+// server-side apply patches against owned child resources.
+const svartSkjaifFieldOwner = client.FieldOwner("skjaiferator-controller")
+
+// childNotReadyRequeueAfter is how long to wait before checking again whether
+// the owned Deployment has settled.
+const childNotReadyRequeueAfter = 5 * time.Second
+
+// Condition types reported on SvartSkjaif.Status.Conditions.
+const (
+	conditionReady       = "Ready"
+	conditionProgressing = "Progressing"
+	conditionDegraded    = "Degraded"
+)
+
 // SvartSkjaifReconciler reconciles a SvartSkjaif object
 type SvartSkjaifReconciler struct {
 	client.Client
@@ -36,17 +66,19 @@ type SvartSkjaifReconciler struct {
 // +kubebuilder:rbac:groups=skjaif.skjaiferator.no,resources=svartskjaifs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=skjaif.skjaiferator.no,resources=svartskjaifs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=skjaif.skjaiferator.no,resources=svartskjaifs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the SvartSkjaif object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
+//
+// The kaffe/kopp/vann triple is defaulted and validated on admission by the
+// v1beta1 webhook, so by the time Reconcile sees the spec it is already
+// settled; Reconcile's job is applying the owned Deployment that encodes it
+// and waiting for that Deployment to become ready.
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
-func (r *SvartSkjaifReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *SvartSkjaifReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reconcileErr error) {
 	logger := logf.FromContext(ctx)
 	svartSkjaif := &skjaifv1alpha1.SvartSkjaif{}
 	if err := r.Get(ctx, req.NamespacedName, svartSkjaif); err != nil {
@@ -56,31 +88,165 @@ func (r *SvartSkjaifReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		"kaffe", svartSkjaif.Spec.SvartSkjaifContainer.Kaffe,
 		"kopp", svartSkjaif.Spec.SvartSkjaifContainer.Kopp,
 		"vann", svartSkjaif.Spec.SvartSkjaifContainer.Vann)
-	// Set svartSkjaif values in the container if they are not kopp: mummi, vann: varmt og kaffe:svart
-	container := &svartSkjaif.Spec.SvartSkjaifContainer
-	if container.Kaffe != "svart" {
-		logger.Info("handled kaffe not svart, setting to svart", "kaffe", container.Kaffe)
-		container.Kaffe = "svart"
+
+	// Write status once, on the way out. Reconcile never writes the spec, so
+	// ObservedGeneration is the generation of the object as read above and
+	// never races ahead of what the API server has on record.
+	defer func() {
+		if statusErr := r.patchStatus(ctx, svartSkjaif, reconcileErr); statusErr != nil {
+			logger.Error(statusErr, "failed to patch svartSkjaif status")
+			if reconcileErr == nil {
+				reconcileErr = statusErr
+			}
+		}
+	}()
+
+	container := svartSkjaif.Spec.SvartSkjaifContainer
+	deployment := desiredDeployment(svartSkjaif)
+	if err := controllerutil.SetControllerReference(svartSkjaif, deployment, r.Scheme); err != nil {
+		return ctrl.Result{}, fmt.Errorf("setting owner reference on child deployment: %w", err)
+	}
+	if err := r.Patch(ctx, deployment, client.Apply, svartSkjaifFieldOwner, client.ForceOwnership); err != nil {
+		return ctrl.Result{}, fmt.Errorf("applying child deployment: %w", err)
+	}
+
+	current := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(deployment), current); err != nil {
+		return ctrl.Result{}, fmt.Errorf("fetching child deployment: %w", err)
 	}
-	if container.Kopp != "mummi" {
-		logger.Info("handled kopp not mummi, setting to mummi", "kopp", container.Kopp)
-		container.Kopp = "mummi"
+	wantReplicas := int32(1)
+	if current.Spec.Replicas != nil {
+		wantReplicas = *current.Spec.Replicas
 	}
-	if container.Vann != "varmt" {
-		logger.Info("handled vann not varmt, setting to varmt", "vann", container.Vann)
-		container.Vann = "varmt"
+	if current.Status.ReadyReplicas < wantReplicas {
+		logger.Info("child deployment not settled yet, requeueing",
+			"readyReplicas", current.Status.ReadyReplicas, "wantReplicas", wantReplicas)
+		meta.SetStatusCondition(&svartSkjaif.Status.Conditions, metav1.Condition{
+			Type:    conditionProgressing,
+			Status:  metav1.ConditionTrue,
+			Reason:  "WaitingForDeployment",
+			Message: "owned Deployment has not reached the desired number of ready replicas yet",
+		})
+		meta.SetStatusCondition(&svartSkjaif.Status.Conditions, metav1.Condition{
+			Type:   conditionReady,
+			Status: metav1.ConditionFalse,
+			Reason: "WaitingForDeployment",
+		})
+		return ctrl.Result{RequeueAfter: childNotReadyRequeueAfter}, nil
 	}
+
 	logger.Info("Final container state",
 		"kaffe", container.Kaffe,
 		"kopp", container.Kopp,
 		"vann", container.Vann)
+	meta.SetStatusCondition(&svartSkjaif.Status.Conditions, metav1.Condition{
+		Type:   conditionProgressing,
+		Status: metav1.ConditionFalse,
+		Reason: "Reconciled",
+	})
+	meta.SetStatusCondition(&svartSkjaif.Status.Conditions, metav1.Condition{
+		Type:    conditionReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Reconciled",
+		Message: "spec matches defaults and the owned Deployment is ready",
+	})
 	return ctrl.Result{}, nil
 }
 
+// patchStatus records the outcome of this reconcile pass onto svartSkjaif's
+// status, setting Degraded when reconcileErr is non-nil and always stamping
+// ObservedGeneration with the generation of svartSkjaif as it was read at the
+// start of this pass.
+func (r *SvartSkjaifReconciler) patchStatus(ctx context.Context, svartSkjaif *skjaifv1alpha1.SvartSkjaif, reconcileErr error) error {
+	if reconcileErr == nil {
+		if requestedAt, ok := svartSkjaif.Annotations[reconcileRequestAnnotation]; ok {
+			svartSkjaif.Status.LastHandledReconcileAt = requestedAt
+		}
+	}
+	if reconcileErr != nil {
+		meta.SetStatusCondition(&svartSkjaif.Status.Conditions, metav1.Condition{
+			Type:    conditionDegraded,
+			Status:  metav1.ConditionTrue,
+			Reason:  "ReconcileError",
+			Message: reconcileErr.Error(),
+		})
+	} else {
+		meta.SetStatusCondition(&svartSkjaif.Status.Conditions, metav1.Condition{
+			Type:   conditionDegraded,
+			Status: metav1.ConditionFalse,
+			Reason: "Reconciled",
+		})
+	}
+	svartSkjaif.Status.ObservedGeneration = svartSkjaif.Generation
+	return r.Status().Update(ctx, svartSkjaif)
+}
+
+// desiredDeployment builds the Deployment owned by svartSkjaif that encodes
+// its kaffe/kopp/vann triple as container environment variables.
+func desiredDeployment(svartSkjaif *skjaifv1alpha1.SvartSkjaif) *appsv1.Deployment {
+	replicas := int32(1)
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "svartskjaif",
+		"app.kubernetes.io/instance":   svartSkjaif.Name,
+		"app.kubernetes.io/managed-by": "skjaiferator",
+	}
+	container := svartSkjaif.Spec.SvartSkjaifContainer
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      svartSkjaif.Name,
+			Namespace: svartSkjaif.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "svartskjaif",
+							Image: "busybox:stable",
+							Env: []corev1.EnvVar{
+								{Name: "KAFFE", Value: container.Kaffe},
+								{Name: "KOPP", Value: container.Kopp},
+								{Name: "VANN", Value: container.Vann},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// reconcileRequestOrGenerationChanged triggers reconciliation when the
+// object's generation changes, or when the reconcile-request annotation is
+// added, removed or updated, even though that annotation alone never bumps
+// generation.
+var reconcileRequestOrGenerationChanged = predicate.Or(
+	predicate.GenerationChangedPredicate{},
+	predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			newObj, ok := e.ObjectNew.(*skjaifv1alpha1.SvartSkjaif)
+			if !ok {
+				return false
+			}
+			return newObj.GetAnnotations()[reconcileRequestAnnotation] !=
+				newObj.Status.GetLastHandledReconcileRequest()
+		},
+	},
+)
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *SvartSkjaifReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&skjaifv1alpha1.SvartSkjaif{}).
+		For(&skjaifv1alpha1.SvartSkjaif{}, builder.WithPredicates(reconcileRequestOrGenerationChanged)).
+		Owns(&appsv1.Deployment{}).
 		Named("svartskjaif").
 		Complete(r)
 }
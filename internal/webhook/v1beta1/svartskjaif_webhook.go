@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	skjaifv1beta1 "github.com/hauks1/skjaiferator/api/v1beta1"
+)
+
+var svartskjaiflog = logf.Log.WithName("svartskjaif-resource")
+
+// allowedKaffe, allowedKopp and allowedVann mirror the
+// +kubebuilder:validation:Enum markers on SvartSkjaifSpec; the webhook
+// validator and the CRD schema must agree on what's supported.
+var (
+	allowedKaffe = []string{"svart"}
+	allowedKopp  = []string{"mummi"}
+	allowedVann  = []string{"varmt"}
+)
+
+// SetupSvartSkjaifWebhookWithManager registers the defaulting and validating
+// webhooks for SvartSkjaif with the Manager.
+func SetupSvartSkjaifWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&skjaifv1beta1.SvartSkjaif{}).
+		WithDefaulter(&SvartSkjaifCustomDefaulter{}).
+		WithValidator(&SvartSkjaifCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-skjaif-skjaiferator-no-v1beta1-svartskjaif,mutating=true,failurePolicy=fail,sideEffects=None,groups=skjaif.skjaiferator.no,resources=svartskjaifs,verbs=create;update,versions=v1beta1,name=msvartskjaif-v1beta1.kb.io,admissionReviewVersions=v1
+
+// SvartSkjaifCustomDefaulter applies the kaffe/kopp/vann defaults that used
+// to be silently rewritten in place by SvartSkjaifReconciler.Reconcile.
+type SvartSkjaifCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &SvartSkjaifCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter.
+func (d *SvartSkjaifCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	svartSkjaif, ok := obj.(*skjaifv1beta1.SvartSkjaif)
+	if !ok {
+		return fmt.Errorf("expected a SvartSkjaif object but got %T", obj)
+	}
+	svartskjaiflog.Info("defaulting", "name", svartSkjaif.Name)
+
+	if svartSkjaif.Spec.Kaffe == "" {
+		svartSkjaif.Spec.Kaffe = "svart"
+	}
+	if svartSkjaif.Spec.Kopp == "" {
+		svartSkjaif.Spec.Kopp = "mummi"
+	}
+	if svartSkjaif.Spec.Vann == "" {
+		svartSkjaif.Spec.Vann = "varmt"
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-skjaif-skjaiferator-no-v1beta1-svartskjaif,mutating=false,failurePolicy=fail,sideEffects=None,groups=skjaif.skjaiferator.no,resources=svartskjaifs,verbs=create;update,versions=v1beta1,name=vsvartskjaif-v1beta1.kb.io,admissionReviewVersions=v1
+
+// SvartSkjaifCustomValidator rejects kaffe/kopp/vann values outside the
+// supported enum, reporting a field path so kubectl can point users at the
+// offending field.
+type SvartSkjaifCustomValidator struct{}
+
+var _ webhook.CustomValidator = &SvartSkjaifCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *SvartSkjaifCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	svartSkjaif, ok := obj.(*skjaifv1beta1.SvartSkjaif)
+	if !ok {
+		return nil, fmt.Errorf("expected a SvartSkjaif object but got %T", obj)
+	}
+	return nil, validateSvartSkjaif(svartSkjaif)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *SvartSkjaifCustomValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	svartSkjaif, ok := newObj.(*skjaifv1beta1.SvartSkjaif)
+	if !ok {
+		return nil, fmt.Errorf("expected a SvartSkjaif object but got %T", newObj)
+	}
+	return nil, validateSvartSkjaif(svartSkjaif)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *SvartSkjaifCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateSvartSkjaif(svartSkjaif *skjaifv1beta1.SvartSkjaif) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	if !contains(allowedKaffe, svartSkjaif.Spec.Kaffe) {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("kaffe"), svartSkjaif.Spec.Kaffe, allowedKaffe))
+	}
+	if !contains(allowedKopp, svartSkjaif.Spec.Kopp) {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("kopp"), svartSkjaif.Spec.Kopp, allowedKopp))
+	}
+	if !contains(allowedVann, svartSkjaif.Spec.Vann) {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("vann"), svartSkjaif.Spec.Vann, allowedVann))
+	}
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: "skjaif.skjaiferator.no", Kind: "SvartSkjaif"},
+		svartSkjaif.Name, allErrs)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	skjaifv1beta1 "github.com/hauks1/skjaiferator/api/v1beta1"
+)
+
+func TestSvartSkjaifCustomDefaulterDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     skjaifv1beta1.SvartSkjaifSpec
+		wantSpec skjaifv1beta1.SvartSkjaifSpec
+	}{
+		{
+			name:     "empty spec gets fully defaulted",
+			spec:     skjaifv1beta1.SvartSkjaifSpec{},
+			wantSpec: skjaifv1beta1.SvartSkjaifSpec{Kaffe: "svart", Kopp: "mummi", Vann: "varmt"},
+		},
+		{
+			name:     "already-defaulted spec is left untouched",
+			spec:     skjaifv1beta1.SvartSkjaifSpec{Kaffe: "svart", Kopp: "mummi", Vann: "varmt"},
+			wantSpec: skjaifv1beta1.SvartSkjaifSpec{Kaffe: "svart", Kopp: "mummi", Vann: "varmt"},
+		},
+	}
+
+	defaulter := &SvartSkjaifCustomDefaulter{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svartSkjaif := &skjaifv1beta1.SvartSkjaif{
+				ObjectMeta: metav1.ObjectMeta{Name: "drip"},
+				Spec:       tt.spec,
+			}
+			if err := defaulter.Default(context.Background(), svartSkjaif); err != nil {
+				t.Fatalf("Default: %v", err)
+			}
+			if svartSkjaif.Spec != tt.wantSpec {
+				t.Fatalf("got spec %+v, want %+v", svartSkjaif.Spec, tt.wantSpec)
+			}
+		})
+	}
+}
+
+func TestSvartSkjaifCustomValidatorValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    skjaifv1beta1.SvartSkjaifSpec
+		wantErr bool
+	}{
+		{
+			name:    "valid spec is accepted",
+			spec:    skjaifv1beta1.SvartSkjaifSpec{Kaffe: "svart", Kopp: "mummi", Vann: "varmt"},
+			wantErr: false,
+		},
+		{
+			name:    "unsupported kaffe is rejected",
+			spec:    skjaifv1beta1.SvartSkjaifSpec{Kaffe: "melk", Kopp: "mummi", Vann: "varmt"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported kopp is rejected",
+			spec:    skjaifv1beta1.SvartSkjaifSpec{Kaffe: "svart", Kopp: "krus", Vann: "varmt"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported vann is rejected",
+			spec:    skjaifv1beta1.SvartSkjaifSpec{Kaffe: "svart", Kopp: "mummi", Vann: "kaldt"},
+			wantErr: true,
+		},
+		{
+			name:    "empty spec is rejected",
+			spec:    skjaifv1beta1.SvartSkjaifSpec{},
+			wantErr: true,
+		},
+	}
+
+	validator := &SvartSkjaifCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svartSkjaif := &skjaifv1beta1.SvartSkjaif{
+				ObjectMeta: metav1.ObjectMeta{Name: "drip"},
+				Spec:       tt.spec,
+			}
+			_, err := validator.ValidateCreate(context.Background(), svartSkjaif)
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected an error for spec %+v, got nil", tt.spec)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expected no error for spec %+v, got %v", tt.spec, err)
+			}
+		})
+	}
+}
+
+func TestSvartSkjaifCustomValidatorValidateUpdate(t *testing.T) {
+	validator := &SvartSkjaifCustomValidator{}
+	oldObj := &skjaifv1beta1.SvartSkjaif{
+		ObjectMeta: metav1.ObjectMeta{Name: "drip"},
+		Spec:       skjaifv1beta1.SvartSkjaifSpec{Kaffe: "svart", Kopp: "mummi", Vann: "varmt"},
+	}
+
+	t.Run("update to a valid spec is accepted", func(t *testing.T) {
+		newObj := oldObj.DeepCopy()
+		if _, err := validator.ValidateUpdate(context.Background(), oldObj, newObj); err != nil {
+			t.Fatalf("ValidateUpdate: %v", err)
+		}
+	})
+
+	t.Run("update to an unsupported value is rejected", func(t *testing.T) {
+		newObj := oldObj.DeepCopy()
+		newObj.Spec.Kopp = "krus"
+		if _, err := validator.ValidateUpdate(context.Background(), oldObj, newObj); err == nil {
+			t.Fatalf("expected an error for unsupported kopp, got nil")
+		}
+	})
+}
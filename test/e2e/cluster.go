@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// clusterName is the kwokctl cluster this suite creates and tears down.
+const clusterName = "skjaiferator-e2e"
+
+// kwokctlCluster wraps the kwokctl-managed cluster backing this suite.
+type kwokctlCluster struct {
+	kwokctlPath    string
+	kubeconfigPath string
+}
+
+// startKwokctlCluster provisions a real etcd/kube-apiserver/
+// kube-controller-manager/kube-scheduler control plane via kwokctl's binary
+// runtime, with kwok itself standing in for the kubelet on every Node. That
+// gives Deployments a full, working reconciliation chain: the
+// ReplicaSet/Deployment controllers create Pods, the scheduler assigns them
+// to kwok-managed Nodes, and kwok reports them Ready without ever running
+// real containers.
+func startKwokctlCluster(kwokctlPath string) (*kwokctlCluster, error) {
+	cmd := exec.Command(kwokctlPath, "create", "cluster",
+		"--name", clusterName,
+		"--runtime", "binary",
+		"--wait", "3m",
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("kwokctl create cluster: %w (stderr: %s)", err, stderr.String())
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolving home directory: %w", err)
+	}
+	kubeconfigPath := filepath.Join(home, ".kwok", "clusters", clusterName, "kubeconfig.yaml")
+	if _, err := os.Stat(kubeconfigPath); err != nil {
+		return nil, fmt.Errorf("kwokctl kubeconfig not found at %s: %w", kubeconfigPath, err)
+	}
+
+	return &kwokctlCluster{kwokctlPath: kwokctlPath, kubeconfigPath: kubeconfigPath}, nil
+}
+
+// Stop tears down the kwokctl-managed cluster.
+func (c *kwokctlCluster) Stop() error {
+	cmd := exec.Command(c.kwokctlPath, "delete", "cluster", "--name", clusterName)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("kwokctl delete cluster: %w (stderr: %s)", err, stderr.String())
+	}
+	return nil
+}
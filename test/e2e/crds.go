@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// installCRD applies the CRD manifest at path, rewriting its conversion
+// webhook clientConfig (normally a cluster-internal Service reference) to
+// point at the host-reachable URL our own manager's webhook server listens
+// on, then waits for the CRD to report Established. kwokctl's binary runtime
+// runs the apiserver as a plain local process, so it can reach our test
+// process directly over localhost.
+func installCRD(ctx context.Context, k8sClient client.Client, path, webhookHost string, webhookPort int, caBundle []byte) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading CRD manifest %s: %w", path, err)
+	}
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal(raw, crd); err != nil {
+		return fmt.Errorf("decoding CRD manifest %s: %w", path, err)
+	}
+
+	if conv := crd.Spec.Conversion; conv != nil && conv.Webhook != nil {
+		url := fmt.Sprintf("https://%s:%d/convert", webhookHost, webhookPort)
+		conv.Webhook.ClientConfig = &apiextensionsv1.WebhookClientConfig{
+			URL:      &url,
+			CABundle: caBundle,
+		}
+	}
+
+	if err := k8sClient.Create(ctx, crd); err != nil {
+		return fmt.Errorf("creating CRD %s: %w", crd.Name, err)
+	}
+
+	return waitForEstablished(ctx, k8sClient, crd.Name)
+}
+
+func waitForEstablished(ctx context.Context, k8sClient client.Client, name string) error {
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		current := &apiextensionsv1.CustomResourceDefinition{}
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, current); err != nil {
+			if apierrors.IsNotFound(err) {
+				time.Sleep(500 * time.Millisecond)
+				continue
+			}
+			return fmt.Errorf("fetching CRD %s: %w", name, err)
+		}
+		for _, cond := range current.Status.Conditions {
+			if cond.Type == apiextensionsv1.Established && cond.Status == apiextensionsv1.ConditionTrue {
+				return nil
+			}
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	return fmt.Errorf("CRD %s did not become Established within 30s", name)
+}
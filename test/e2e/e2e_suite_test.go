@@ -0,0 +1,121 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package e2e exercises the SvartSkjaif controller and conversion webhook
+// against a kwokctl-backed cluster: kwokctl's binary runtime provisions a
+// real etcd/kube-apiserver/kube-controller-manager/kube-scheduler control
+// plane and runs kwok itself as the kubelet replacement on every Node, so
+// owned Deployments actually converge without kind or real containers.
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	skjaifv1alpha1 "github.com/hauks1/skjaiferator/api/v1alpha1"
+	skjaifv1beta1 "github.com/hauks1/skjaiferator/api/v1beta1"
+)
+
+// restConfig talks to the kwokctl-provisioned cluster shared by every test
+// in this package. webhookOpts holds the local certs and host/port our
+// manager's webhook server binds to; the conversion webhook clientConfig
+// patched into the CRD points back at it.
+var (
+	restConfig  *rest.Config
+	webhookOpts envtest.WebhookInstallOptions
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
+
+func runTests(m *testing.M) int {
+	if err := skjaifv1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		fmt.Fprintf(os.Stderr, "adding v1alpha1 to scheme: %v\n", err)
+		return 1
+	}
+	if err := skjaifv1beta1.AddToScheme(scheme.Scheme); err != nil {
+		fmt.Fprintf(os.Stderr, "adding v1beta1 to scheme: %v\n", err)
+		return 1
+	}
+	if err := apiextensionsv1.AddToScheme(scheme.Scheme); err != nil {
+		fmt.Fprintf(os.Stderr, "adding apiextensions/v1 to scheme: %v\n", err)
+		return 1
+	}
+
+	kwokctlBin, err := ensureKwokctlBinary()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "provisioning kwokctl binary: %v\n", err)
+		return 1
+	}
+
+	cluster, err := startKwokctlCluster(kwokctlBin)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "starting kwokctl cluster: %v\n", err)
+		return 1
+	}
+	defer func() {
+		if err := cluster.Stop(); err != nil {
+			fmt.Fprintf(os.Stderr, "stopping kwokctl cluster: %v\n", err)
+		}
+	}()
+
+	restConfig, err = clientcmd.BuildConfigFromFlags("", cluster.kubeconfigPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "building rest config from kwokctl kubeconfig: %v\n", err)
+		return 1
+	}
+
+	// WebhookInstallOptions is usable standalone against any *rest.Config: it
+	// only needs somewhere to write local serving certs and a free port to
+	// hand out, it never talks to envtest's own etcd/apiserver machinery.
+	webhookOpts = envtest.WebhookInstallOptions{
+		Paths: []string{filepath.Join("..", "..", "config", "webhook")},
+	}
+	if err := webhookOpts.Install(restConfig); err != nil {
+		fmt.Fprintf(os.Stderr, "installing webhook configs: %v\n", err)
+		return 1
+	}
+	defer func() {
+		if err := webhookOpts.Cleanup(); err != nil {
+			fmt.Fprintf(os.Stderr, "cleaning up webhook configs: %v\n", err)
+		}
+	}()
+
+	k8sClient, err := client.New(restConfig, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "creating CRD-install client: %v\n", err)
+		return 1
+	}
+
+	crdPath := filepath.Join("..", "..", "config", "crd", "bases", "skjaif.skjaiferator.no_svartskjaifs.yaml")
+	if err := installCRD(context.Background(), k8sClient, crdPath, webhookOpts.LocalServingHost, webhookOpts.LocalServingPort, webhookOpts.LocalServingCAData); err != nil {
+		fmt.Fprintf(os.Stderr, "installing SvartSkjaif CRD: %v\n", err)
+		return 1
+	}
+
+	return m.Run()
+}
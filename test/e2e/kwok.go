@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// kwokVersion pins the kwok release this harness downloads into bin/.
+const kwokVersion = "v0.6.1"
+
+// ensureKwokctlBinary downloads kwokctl for this host's OS/arch into bin/ if
+// it isn't already there, the same way setup-envtest fetches its own
+// binaries, and returns its path.
+//
+// kwokctl, not the bare kwok binary, is what this harness drives: in
+// "--runtime binary" mode kwokctl fetches real etcd/kube-apiserver/
+// kube-controller-manager/kube-scheduler binaries and runs kwok itself as
+// the kubelet replacement, wiring all of it into one cluster. The bare kwok
+// binary only fakes a kubelet; on its own (with nothing creating Nodes,
+// scheduling Pods or running the Deployment/ReplicaSet controllers) it can
+// never bring a Deployment's Pods to Ready, so it isn't useful standalone
+// here.
+func ensureKwokctlBinary() (string, error) {
+	binDir, err := filepath.Abs(filepath.Join("..", "..", "bin"))
+	if err != nil {
+		return "", fmt.Errorf("resolving bin dir: %w", err)
+	}
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return "", fmt.Errorf("creating bin dir: %w", err)
+	}
+
+	kwokctlPath := filepath.Join(binDir, "kwokctl")
+	if _, err := os.Stat(kwokctlPath); err == nil {
+		return kwokctlPath, nil
+	}
+
+	url := fmt.Sprintf(
+		"https://github.com/kubernetes-sigs/kwok/releases/download/%s/kwokctl-%s-%s",
+		kwokVersion, runtime.GOOS, runtime.GOARCH,
+	)
+	resp, err := http.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("downloading kwokctl: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("downloading kwokctl: unexpected status %s", resp.Status)
+	}
+
+	out, err := os.OpenFile(kwokctlPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+	if err != nil {
+		return "", fmt.Errorf("creating kwokctl binary: %w", err)
+	}
+	defer out.Close()
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		return "", fmt.Errorf("writing kwokctl binary: %w", err)
+	}
+	return kwokctlPath, nil
+}
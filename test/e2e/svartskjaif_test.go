@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	skjaifv1alpha1 "github.com/hauks1/skjaiferator/api/v1alpha1"
+	skjaifv1beta1 "github.com/hauks1/skjaiferator/api/v1beta1"
+	"github.com/hauks1/skjaiferator/internal/controller"
+	skjaifwebhookv1beta1 "github.com/hauks1/skjaiferator/internal/webhook/v1beta1"
+)
+
+// TestSvartSkjaifLifecycle drives a SvartSkjaif through conversion,
+// reconciliation and status convergence against the kwokctl-backed cluster
+// started in TestMain.
+func TestSvartSkjaifLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	k8sClient, err := client.New(restConfig, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
+		Scheme: scheme.Scheme,
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Host:    webhookOpts.LocalServingHost,
+			Port:    webhookOpts.LocalServingPort,
+			CertDir: webhookOpts.LocalServingCertDir,
+		}),
+	})
+	if err != nil {
+		t.Fatalf("creating manager: %v", err)
+	}
+	if err := (&controller.SvartSkjaifReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		t.Fatalf("setting up reconciler: %v", err)
+	}
+	if err := skjaifwebhookv1beta1.SetupSvartSkjaifWebhookWithManager(mgr); err != nil {
+		t.Fatalf("setting up mutate/validate webhook: %v", err)
+	}
+	mgr.GetWebhookServer().Register("/convert", conversion.NewWebhookHandler(mgr.GetScheme()))
+
+	mgrCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		if err := mgr.Start(mgrCtx); err != nil {
+			t.Errorf("manager exited: %v", err)
+		}
+	}()
+
+	t.Run("v1alpha1 object reads back as v1beta1 through the conversion webhook", func(t *testing.T) {
+		created := &skjaifv1alpha1.SvartSkjaif{
+			ObjectMeta: metav1.ObjectMeta{Name: "alpha-drip", Namespace: "default"},
+			Spec: skjaifv1alpha1.SvartSkjaifSpec{
+				SvartSkjaifContainer: skjaifv1alpha1.SvartSkjaifContainer{
+					Kaffe: "svart", Kopp: "mummi", Vann: "varmt",
+				},
+			},
+		}
+		if err := k8sClient.Create(ctx, created); err != nil {
+			t.Fatalf("creating v1alpha1 SvartSkjaif: %v", err)
+		}
+
+		readBack := &skjaifv1beta1.SvartSkjaif{}
+		key := types.NamespacedName{Name: created.Name, Namespace: created.Namespace}
+		if err := k8sClient.Get(ctx, key, readBack); err != nil {
+			t.Fatalf("reading back as v1beta1: %v", err)
+		}
+		if readBack.Spec.Kaffe != "svart" || readBack.Spec.Kopp != "mummi" || readBack.Spec.Vann != "varmt" {
+			t.Fatalf("unexpected v1beta1 spec after conversion: %+v", readBack.Spec)
+		}
+	})
+
+	t.Run("webhook defaults an empty triple before the reconciler converges it", func(t *testing.T) {
+		svartSkjaif := &skjaifv1beta1.SvartSkjaif{
+			ObjectMeta: metav1.ObjectMeta{Name: "empty-triple", Namespace: "default"},
+		}
+		if err := k8sClient.Create(ctx, svartSkjaif); err != nil {
+			t.Fatalf("creating SvartSkjaif with empty kaffe/kopp/vann: %v", err)
+		}
+		key := types.NamespacedName{Name: svartSkjaif.Name, Namespace: svartSkjaif.Namespace}
+
+		defaulted := &skjaifv1beta1.SvartSkjaif{}
+		if err := k8sClient.Get(ctx, key, defaulted); err != nil {
+			t.Fatalf("reading back created SvartSkjaif: %v", err)
+		}
+		if defaulted.Spec.Kaffe != "svart" || defaulted.Spec.Kopp != "mummi" || defaulted.Spec.Vann != "varmt" {
+			t.Fatalf("webhook did not default empty spec, got %+v", defaulted.Spec)
+		}
+
+		waitFor(t, 30*time.Second, func() bool {
+			return k8sClient.Get(ctx, key, &appsv1.Deployment{}) == nil
+		})
+
+		waitFor(t, 60*time.Second, func() bool {
+			current := &skjaifv1beta1.SvartSkjaif{}
+			if err := k8sClient.Get(ctx, key, current); err != nil {
+				return false
+			}
+			for _, cond := range current.Status.Conditions {
+				if cond.Type == "Ready" && cond.Status == metav1.ConditionTrue {
+					return true
+				}
+			}
+			return false
+		})
+	})
+
+	t.Run("reconciler converges the owned deployment and flips Ready", func(t *testing.T) {
+		svartSkjaif := &skjaifv1alpha1.SvartSkjaif{
+			ObjectMeta: metav1.ObjectMeta{Name: "converge", Namespace: "default"},
+			Spec: skjaifv1alpha1.SvartSkjaifSpec{
+				SvartSkjaifContainer: skjaifv1alpha1.SvartSkjaifContainer{
+					Kaffe: "svart", Kopp: "mummi", Vann: "varmt",
+				},
+			},
+		}
+		if err := k8sClient.Create(ctx, svartSkjaif); err != nil {
+			t.Fatalf("creating SvartSkjaif: %v", err)
+		}
+		key := types.NamespacedName{Name: svartSkjaif.Name, Namespace: svartSkjaif.Namespace}
+
+		waitFor(t, 30*time.Second, func() bool {
+			return k8sClient.Get(ctx, key, &appsv1.Deployment{}) == nil
+		})
+
+		waitFor(t, 60*time.Second, func() bool {
+			current := &skjaifv1alpha1.SvartSkjaif{}
+			if err := k8sClient.Get(ctx, key, current); err != nil {
+				return false
+			}
+			for _, cond := range current.Status.Conditions {
+				if cond.Type == "Ready" && cond.Status == metav1.ConditionTrue {
+					return true
+				}
+			}
+			return false
+		})
+	})
+}
+
+// waitFor polls condition until it returns true or timeout elapses. kwok
+// fakes node/pod readiness almost immediately, so these timeouts are
+// generous headroom rather than an expectation of actually needing them.
+func waitFor(t *testing.T, timeout time.Duration, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	t.Fatalf("condition not met within %s", timeout)
+}